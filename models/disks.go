@@ -1,6 +1,10 @@
 package models
 
-import "github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+import (
+	"strconv"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
 
 // TODO: Consider implementing Disks.CalculateActions([]compute.VirtualMachineDisk)
 
@@ -32,6 +36,36 @@ func (disks Disks) ToMaps() []map[string]interface{} {
 	return diskPropertyList
 }
 
+// TotalSizeGB calculates the combined size (in GB) of all the Disks.
+func (disks Disks) TotalSizeGB() int {
+	totalSizeGB := 0
+	for _, disk := range disks {
+		totalSizeGB += disk.SizeGB
+	}
+
+	return totalSizeGB
+}
+
+// IDsByUnitID creates a map of disk Ids keyed by SCSI unit Id (formatted as a string, for use in Terraform state).
+func (disks Disks) IDsByUnitID() map[string]interface{} {
+	diskIDsByUnitID := make(map[string]interface{})
+	for _, disk := range disks {
+		diskIDsByUnitID[strconv.Itoa(disk.SCSIUnitID)] = disk.ID
+	}
+
+	return diskIDsByUnitID
+}
+
+// SizesByUnitID creates a map of disk sizes (in GB) keyed by SCSI unit Id (formatted as a string, for use in Terraform state).
+func (disks Disks) SizesByUnitID() map[string]interface{} {
+	diskSizesByUnitID := make(map[string]interface{})
+	for _, disk := range disks {
+		diskSizesByUnitID[strconv.Itoa(disk.SCSIUnitID)] = strconv.Itoa(disk.SizeGB)
+	}
+
+	return diskSizesByUnitID
+}
+
 // ByUnitID creates a map of Disk keyed by SCSI unit Id.
 func (disks Disks) ByUnitID() map[int]Disk {
 	disksByUnitID := make(map[int]Disk)
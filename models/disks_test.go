@@ -187,3 +187,128 @@ func TestSplitConfiguredDisksByActionNew1Changed2(test *testing.T) {
 
 	assert.EqualsInt("RemoveDisks.Length", 0, len(removeDisks))
 }
+
+// Unit test - splitConfiguredDisksByAction with 1 removed disk.
+func TestSplitConfiguredDisksByActionRemoved1(test *testing.T) {
+	configuredDisks := Disks{
+		Disk{
+			SCSIUnitID: 0,
+			SizeGB:     5,
+			Speed:      "STANDARD",
+		},
+		Disk{
+			SCSIUnitID: 2,
+			SizeGB:     20,
+			Speed:      "STANDARD",
+		},
+	}
+	actualDisks := Disks{
+		Disk{
+			ID:         "7a3ab4b1-f5b0-4cb3-a2e4-c6e5bfe0f8b4",
+			SCSIUnitID: 0,
+			SizeGB:     5,
+			Speed:      "STANDARD",
+		},
+		Disk{
+			ID:         "92b3e2e4-a0c4-4e4e-8f2a-4a3c4b3c5d2e",
+			SCSIUnitID: 1,
+			SizeGB:     10,
+			Speed:      "STANDARD",
+		},
+		Disk{
+			ID:         "c2a4e5d3-3b8f-4f4e-a0bd-8c5d7e2f1a6b",
+			SCSIUnitID: 2,
+			SizeGB:     20,
+			Speed:      "STANDARD",
+		},
+	}
+
+	addDisks, changeDisks, removeDisks := configuredDisks.SplitByAction(actualDisks)
+
+	assert := assert.ForTest(test)
+	assert.EqualsInt("AddDisks.Length", 0, len(addDisks))
+	assert.EqualsInt("ChangeDisks.Length", 0, len(changeDisks))
+	assert.EqualsInt("RemoveDisks.Length", 1, len(removeDisks))
+	assert.EqualsInt("RemoveDisks[0].SCSIUnitID", 1, removeDisks[0].SCSIUnitID)
+	assert.EqualsString("RemoveDisks[0].ID", "92b3e2e4-a0c4-4e4e-8f2a-4a3c4b3c5d2e", removeDisks[0].ID)
+}
+
+// Unit test - splitConfiguredDisksByAction with a speed-only change to the OS disk.
+func TestSplitConfiguredDisksByActionOSDiskSpeedChanged(test *testing.T) {
+	configuredDisks := Disks{
+		Disk{
+			ID:         "os-disk",
+			SCSIUnitID: 0,
+			SizeGB:     10,
+			Speed:      "HIGHPERFORMANCE",
+		},
+	}
+	actualDisks := Disks{
+		Disk{
+			ID:         "os-disk",
+			SCSIUnitID: 0,
+			SizeGB:     10,
+			Speed:      "STANDARD",
+		},
+	}
+
+	addDisks, changeDisks, removeDisks := configuredDisks.SplitByAction(actualDisks)
+
+	assert := assert.ForTest(test)
+	assert.EqualsInt("AddDisks.Length", 0, len(addDisks))
+
+	assert.EqualsInt("ChangeDisks.Length", 1, len(changeDisks))
+	assert.EqualsInt("ChangeDisks[0].SCSIUnitID", 0, changeDisks[0].SCSIUnitID)
+	assert.EqualsInt("ChangeDisks[0].SizeGB", 10, changeDisks[0].SizeGB)
+	assert.EqualsString("ChangeDisks[0].Speed", "HIGHPERFORMANCE", changeDisks[0].Speed)
+
+	assert.EqualsInt("RemoveDisks.Length", 0, len(removeDisks))
+}
+
+// Unit test - Disks.TotalSizeGB
+func TestDisksTotalSizeGB(test *testing.T) {
+	disks := Disks{
+		Disk{
+			SCSIUnitID: 0,
+			SizeGB:     10,
+			Speed:      "STANDARD",
+		},
+		Disk{
+			SCSIUnitID: 1,
+			SizeGB:     20,
+			Speed:      "HIGHPERFORMANCE",
+		},
+	}
+
+	assert := assert.ForTest(test)
+	assert.EqualsInt("TotalSizeGB", 30, disks.TotalSizeGB())
+	assert.EqualsInt("Empty.TotalSizeGB", 0, Disks{}.TotalSizeGB())
+}
+
+// Unit test - Disks.IDsByUnitID and Disks.SizesByUnitID
+func TestDisksByUnitIDMaps(test *testing.T) {
+	disks := Disks{
+		Disk{
+			ID:         "disk-0",
+			SCSIUnitID: 0,
+			SizeGB:     10,
+		},
+		Disk{
+			ID:         "disk-2",
+			SCSIUnitID: 2,
+			SizeGB:     20,
+		},
+	}
+
+	assert := assert.ForTest(test)
+
+	diskIDs := disks.IDsByUnitID()
+	assert.EqualsInt("IDsByUnitID.Length", 2, len(diskIDs))
+	assert.Equals("IDsByUnitID[0]", "disk-0", diskIDs["0"])
+	assert.Equals("IDsByUnitID[2]", "disk-2", diskIDs["2"])
+
+	diskSizes := disks.SizesByUnitID()
+	assert.EqualsInt("SizesByUnitID.Length", 2, len(diskSizes))
+	assert.Equals("SizesByUnitID[0]", "10", diskSizes["0"])
+	assert.Equals("SizesByUnitID[2]", "20", diskSizes["2"])
+}
@@ -13,6 +13,11 @@ type NetworkAdapter struct {
 	PrivateIPv4Address string
 	PrivateIPv6Address string
 	AdapterType        string
+
+	// The name of the VLAN to which the network adapter should be attached (resolved to VLANID before deployment).
+	//
+	// This is configuration-only; CloudControl does not report it, so it is not populated from a VirtualMachineNetworkAdapter.
+	VLANName string
 }
 
 // HasExplicitType determines whether the NetworkAdapter explicitly declares an adapter type.
@@ -27,6 +32,7 @@ func (networkAdapter *NetworkAdapter) ReadMap(networkAdapterProperties map[strin
 	networkAdapter.ID = reader.GetString("id")
 	networkAdapter.MACAddress = reader.GetString("mac")
 	networkAdapter.VLANID = reader.GetString("vlan")
+	networkAdapter.VLANName = reader.GetString("vlan_name")
 	networkAdapter.PrivateIPv4Address = reader.GetString("ipv4")
 	networkAdapter.PrivateIPv6Address = reader.GetString("ipv6")
 	networkAdapter.AdapterType = reader.GetString("type")
@@ -47,6 +53,7 @@ func (networkAdapter *NetworkAdapter) UpdateMap(networkAdapterProperties map[str
 	writer.SetString("id", networkAdapter.ID)
 	writer.SetString("mac", networkAdapter.MACAddress)
 	writer.SetString("vlan", networkAdapter.VLANID)
+	writer.SetString("vlan_name", networkAdapter.VLANName)
 	writer.SetString("ipv4", networkAdapter.PrivateIPv4Address)
 	writer.SetString("ipv6", networkAdapter.PrivateIPv6Address)
 	writer.SetString("type", networkAdapter.AdapterType)
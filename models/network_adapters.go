@@ -60,6 +60,41 @@ func (networkAdapters NetworkAdapters) GetByID(id string) *NetworkAdapter {
 	return nil
 }
 
+// GetByPrivateIPv4Address retrieves the NetworkAdapter (if any) with the specified private IPv4 address.
+func (networkAdapters NetworkAdapters) GetByPrivateIPv4Address(privateIPv4Address string) *NetworkAdapter {
+	if privateIPv4Address == "" {
+		return nil
+	}
+
+	for index := range networkAdapters {
+		networkAdapter := &networkAdapters[index]
+		if networkAdapter.PrivateIPv4Address == privateIPv4Address {
+			return networkAdapter
+		}
+	}
+
+	return nil
+}
+
+// GetByIDOrMACAddress retrieves the NetworkAdapter (if any) with the specified Id or, if no NetworkAdapter has that Id, the specified MAC address.
+func (networkAdapters NetworkAdapters) GetByIDOrMACAddress(id string, macAddress string) *NetworkAdapter {
+	networkAdapter := networkAdapters.GetByID(id)
+	if networkAdapter != nil {
+		return networkAdapter
+	}
+
+	if macAddress == "" {
+		return nil
+	}
+
+	networkAdapterByMACAddress, ok := networkAdapters.ByMACAddress()[macAddress]
+	if !ok {
+		return nil
+	}
+
+	return &networkAdapterByMACAddress
+}
+
 // Insert a NetworkAdapter at the specified index.
 //
 // Returns a new NetworkAdapters.
@@ -158,6 +193,20 @@ func (networkAdapters NetworkAdapters) CaptureIDs(virtualMachineNetwork compute.
 	}
 }
 
+// CaptureVLANNames updates each NetworkAdapter with the VLAN name (if any) of the configured NetworkAdapter that has the same Id.
+//
+// CloudControl does not report VLAN names for network adapters, so they must be carried over from configuration.
+func (networkAdapters NetworkAdapters) CaptureVLANNames(configuredNetworkAdapters NetworkAdapters) {
+	for index := range networkAdapters {
+		networkAdapter := &networkAdapters[index]
+
+		configuredNetworkAdapter := configuredNetworkAdapters.GetByID(networkAdapter.ID)
+		if configuredNetworkAdapter != nil {
+			networkAdapter.VLANName = configuredNetworkAdapter.VLANName
+		}
+	}
+}
+
 // ReadVirtualMachineNetwork updates each NetworkAdapter with values from the corresponding compute.VirtualMachineNetworkAdapter (if one is found with the same Id).
 func (networkAdapters NetworkAdapters) ReadVirtualMachineNetwork(virtualMachineNetwork compute.VirtualMachineNetwork) {
 	actualNetworkAdaptersByID := NewNetworkAdaptersFromVirtualMachineNetwork(virtualMachineNetwork).ByID()
@@ -123,3 +123,114 @@ func TestSubtractNetworkAdapters_3_3_Same(test *testing.T) {
 	assert.EqualsInt("RemovedAdapters.Length", 1, len(removedAdapters))
 	assert.EqualsString("RemovedAdapters[0].ID", "aad233e6-8229-4a47-be42-cc0b449eb03f", removedAdapters[0].ID)
 }
+
+// Unit test - given 3 network adapters with explicit types, populate a VirtualMachineNetwork for deployment.
+func TestNetworkAdaptersUpdateVirtualMachineNetwork_3_Types(test *testing.T) {
+	networkAdapters := NetworkAdapters{
+		NetworkAdapter{
+			VLANID:      "686bca8d-3cfa-461a-b4ad-88fd77219947",
+			AdapterType: "VMXNET3",
+		},
+		NetworkAdapter{
+			VLANID:      "6f84dce4-1ec6-4992-bf02-df15d4d3dd37",
+			AdapterType: "E1000",
+		},
+		NetworkAdapter{
+			PrivateIPv4Address: "192.168.19.20",
+		},
+	}
+
+	virtualMachineNetwork := networkAdapters.ToVirtualMachineNetwork()
+
+	assert := assert.ForTest(test)
+	assert.NotNil("PrimaryAdapter.AdapterType", virtualMachineNetwork.PrimaryAdapter.AdapterType)
+	assert.EqualsString("PrimaryAdapter.AdapterType", "VMXNET3", *virtualMachineNetwork.PrimaryAdapter.AdapterType)
+
+	assert.EqualsInt("AdditionalNetworkAdapters.Length", 2, len(virtualMachineNetwork.AdditionalNetworkAdapters))
+	assert.NotNil("AdditionalNetworkAdapters[0].AdapterType", virtualMachineNetwork.AdditionalNetworkAdapters[0].AdapterType)
+	assert.EqualsString("AdditionalNetworkAdapters[0].AdapterType", "E1000", *virtualMachineNetwork.AdditionalNetworkAdapters[0].AdapterType)
+	assert.IsTrue("AdditionalNetworkAdapters[1].AdapterType == nil", virtualMachineNetwork.AdditionalNetworkAdapters[1].AdapterType == nil)
+}
+
+// Unit test - given 2 network adapters, locate an adapter by MAC address when its Id is unknown.
+func TestNetworkAdaptersGetByIDOrMACAddress_MACAddress(test *testing.T) {
+	networkAdapters := NetworkAdapters{
+		NetworkAdapter{
+			ID:         "7b8fb12e-9ce6-440e-8a0f-2a139f878967",
+			MACAddress: "00:50:56:a3:79:5e",
+		},
+		NetworkAdapter{
+			ID:         "aad233e6-8229-4a47-be42-cc0b449eb03f",
+			MACAddress: "00:50:56:a3:5c:79",
+		},
+	}
+
+	assert := assert.ForTest(test)
+
+	networkAdapter := networkAdapters.GetByIDOrMACAddress("aad233e6-8229-4a47-be42-cc0b449eb03f", "")
+	assert.NotNil("NetworkAdapter (by Id)", networkAdapter)
+	assert.EqualsString("NetworkAdapter.ID", "aad233e6-8229-4a47-be42-cc0b449eb03f", networkAdapter.ID)
+
+	networkAdapter = networkAdapters.GetByIDOrMACAddress("83fe7621-278c-4f13-82a6-6848a623cd7f", "00:50:56:a3:5c:79")
+	assert.NotNil("NetworkAdapter (by MAC address)", networkAdapter)
+	assert.EqualsString("NetworkAdapter.ID", "aad233e6-8229-4a47-be42-cc0b449eb03f", networkAdapter.ID)
+
+	networkAdapter = networkAdapters.GetByIDOrMACAddress("83fe7621-278c-4f13-82a6-6848a623cd7f", "00:50:56:a3:68:f2")
+	assert.IsTrue("NetworkAdapter == nil", networkAdapter == nil)
+}
+
+// Unit test - given 2 network adapters, locate an adapter by private IPv4 address.
+func TestNetworkAdaptersGetByPrivateIPv4Address(test *testing.T) {
+	networkAdapters := NetworkAdapters{
+		NetworkAdapter{
+			ID:                 "7b8fb12e-9ce6-440e-8a0f-2a139f878967",
+			PrivateIPv4Address: "192.168.17.20",
+		},
+		NetworkAdapter{
+			ID:                 "aad233e6-8229-4a47-be42-cc0b449eb03f",
+			PrivateIPv4Address: "192.168.18.20",
+		},
+	}
+
+	assert := assert.ForTest(test)
+
+	networkAdapter := networkAdapters.GetByPrivateIPv4Address("192.168.18.20")
+	assert.NotNil("NetworkAdapter", networkAdapter)
+	assert.EqualsString("NetworkAdapter.ID", "aad233e6-8229-4a47-be42-cc0b449eb03f", networkAdapter.ID)
+
+	networkAdapter = networkAdapters.GetByPrivateIPv4Address("192.168.19.20")
+	assert.IsTrue("NetworkAdapter == nil", networkAdapter == nil)
+}
+
+// Unit test - VLAN names are carried over from configured network adapters with the same Id.
+func TestNetworkAdaptersCaptureVLANNames(test *testing.T) {
+	configuredNetworkAdapters := NetworkAdapters{
+		NetworkAdapter{
+			ID:       "7b8fb12e-9ce6-440e-8a0f-2a139f878967",
+			VLANName: "web",
+		},
+		NetworkAdapter{
+			ID: "aad233e6-8229-4a47-be42-cc0b449eb03f",
+		},
+	}
+	networkAdapters := NetworkAdapters{
+		NetworkAdapter{
+			ID:     "7b8fb12e-9ce6-440e-8a0f-2a139f878967",
+			VLANID: "b7b6f3a4-9f2d-4d5c-8c4e-5a1f0b3e2d6c",
+		},
+		NetworkAdapter{
+			ID: "aad233e6-8229-4a47-be42-cc0b449eb03f",
+		},
+		NetworkAdapter{
+			ID: "83fe7621-278c-4f13-82a6-6848a623cd7f",
+		},
+	}
+
+	networkAdapters.CaptureVLANNames(configuredNetworkAdapters)
+
+	assert := assert.ForTest(test)
+	assert.EqualsString("NetworkAdapters[0].VLANName", "web", networkAdapters[0].VLANName)
+	assert.EqualsString("NetworkAdapters[0].VLANID", "b7b6f3a4-9f2d-4d5c-8c4e-5a1f0b3e2d6c", networkAdapters[0].VLANID)
+	assert.EqualsString("NetworkAdapters[1].VLANName", "", networkAdapters[1].VLANName)
+	assert.EqualsString("NetworkAdapters[2].VLANName", "", networkAdapters[2].VLANName)
+}
@@ -3,6 +3,9 @@ package retry
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 )
 
 // Context represents contextual information about the current iteration of a retryable operation.
@@ -10,10 +13,15 @@ type Context interface {
 	// Retry the operation once the current iteration completes.
 	Retry()
 
+	// RetryFor retries the operation once the current iteration completes, recording the reason (e.g. a CloudControl response code) for the retry.
+	RetryFor(reason string)
+
 	// Mark the current iteration as failed due to the specified error.
 	Fail(err error)
 }
 
+const unspecifiedRetryReason = "UNSPECIFIED"
+
 // Create a new doContext.
 func newDoContext(operationDescription string) *doContext {
 	return &doContext{
@@ -21,6 +29,7 @@ func newDoContext(operationDescription string) *doContext {
 		IterationCount:       0,
 		ShouldRetry:          false,
 		Error:                nil,
+		RetryReasons:         make(map[string]int),
 	}
 }
 
@@ -29,13 +38,31 @@ type doContext struct {
 	IterationCount       int
 	ShouldRetry          bool
 	Error                error
+
+	// The number of times each reason has triggered a retry.
+	RetryReasons map[string]int
+
+	// The total time spent waiting between attempts.
+	WaitTime time.Duration
+
+	lastAttemptCompleted time.Time
 }
 
 var _ Context = &doContext{}
 
 // Retry the operation once the current iteration completes.
 func (context *doContext) Retry() {
+	context.RetryFor(unspecifiedRetryReason)
+}
+
+// RetryFor retries the operation once the current iteration completes, recording the reason (e.g. a CloudControl response code) for the retry.
+func (context *doContext) RetryFor(reason string) {
+	if reason == "" {
+		reason = unspecifiedRetryReason
+	}
+
 	context.ShouldRetry = true
+	context.RetryReasons[reason]++
 }
 
 // Mark the current iteration as failed due to the specified error.
@@ -60,7 +87,52 @@ func (context *doContext) Fail(err error) {
 
 // NextIteration resets the context for the next iteration.
 func (context *doContext) NextIteration() {
+	if !context.lastAttemptCompleted.IsZero() {
+		context.WaitTime += time.Since(context.lastAttemptCompleted)
+	}
+
 	context.ShouldRetry = false
 	context.Error = nil
 	context.IterationCount++
 }
+
+// EndIteration records the completion of the current iteration.
+func (context *doContext) EndIteration() {
+	context.lastAttemptCompleted = time.Now()
+}
+
+// RetryCount determines the number of times the operation has been retried.
+func (context *doContext) RetryCount() int {
+	if context.IterationCount == 0 {
+		return 0
+	}
+
+	return context.IterationCount - 1
+}
+
+// HasRetried determines whether the operation has been retried (or marked for retry) at least once.
+func (context *doContext) HasRetried() bool {
+	return context.RetryCount() > 0 || len(context.RetryReasons) > 0
+}
+
+// Summary creates a one-line summary of the retries performed for the operation.
+func (context *doContext) Summary() string {
+	reasons := make([]string, 0, len(context.RetryReasons))
+	for reason, count := range context.RetryReasons {
+		reasons = append(reasons, fmt.Sprintf("%s x %d", reason, count))
+	}
+	sort.Strings(reasons)
+
+	reasonDescription := "none"
+	if len(reasons) > 0 {
+		reasonDescription = strings.Join(reasons, ", ")
+	}
+
+	return fmt.Sprintf("%s - retry summary: %d attempts, %d retries, %d seconds spent waiting between attempts (retries triggered by: %s)",
+		context.OperationDescription,
+		context.IterationCount,
+		context.RetryCount(),
+		context.WaitTime/time.Second,
+		reasonDescription,
+	)
+}
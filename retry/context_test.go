@@ -0,0 +1,65 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DimensionDataResearch/dd-cloud-compute-terraform/assert"
+)
+
+// Unit test - RetryFor marks the operation for retry and counts retries by reason.
+func TestDoContextRetryFor(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	context := newDoContext("Test operation")
+	context.NextIteration()
+	assert.IsFalse("HasRetried (initial attempt)", context.HasRetried())
+
+	context.RetryFor("RESOURCE_BUSY")
+	assert.IsTrue("ShouldRetry", context.ShouldRetry)
+	assert.IsTrue("HasRetried (marked for retry)", context.HasRetried())
+
+	context.NextIteration()
+	assert.IsFalse("ShouldRetry (after NextIteration)", context.ShouldRetry)
+	context.RetryFor("RESOURCE_BUSY")
+	context.NextIteration()
+	context.RetryFor("")
+
+	assert.EqualsInt("RetryCount", 2, context.RetryCount())
+	assert.EqualsInt("RetryReasons[RESOURCE_BUSY]", 2, context.RetryReasons["RESOURCE_BUSY"])
+	assert.EqualsInt("RetryReasons[UNSPECIFIED]", 1, context.RetryReasons[unspecifiedRetryReason])
+}
+
+// Unit test - the summary includes the attempt / retry counts, time spent waiting, and the reasons for retries (in a stable order).
+func TestDoContextSummary(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	context := newDoContext("Test operation")
+	context.NextIteration()
+	context.RetryFor("UNEXPECTED_ERROR")
+	context.NextIteration()
+	context.RetryFor("RESOURCE_BUSY")
+	context.NextIteration()
+	context.RetryFor("RESOURCE_BUSY")
+	context.NextIteration()
+	context.WaitTime = 12 * time.Second
+
+	assert.EqualsString("Summary",
+		"Test operation - retry summary: 4 attempts, 3 retries, 12 seconds spent waiting between attempts (retries triggered by: RESOURCE_BUSY x 2, UNEXPECTED_ERROR x 1)",
+		context.Summary(),
+	)
+}
+
+// Unit test - the summary of an operation that was never retried says so.
+func TestDoContextSummary_NoRetries(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	context := newDoContext("Test operation")
+	context.NextIteration()
+
+	assert.IsFalse("HasRetried", context.HasRetried())
+	assert.EqualsString("Summary",
+		"Test operation - retry summary: 1 attempts, 0 retries, 0 seconds spent waiting between attempts (retries triggered by: none)",
+		context.Summary(),
+	)
+}
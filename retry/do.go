@@ -101,6 +101,13 @@ func (do *doWithRetry) Action(description string, timeout time.Duration, action
 	)
 
 	context := newDoContext(description)
+	defer func() {
+		// Only worth mentioning if the operation was retried.
+		if context.HasRetried() {
+			log.Print(context.Summary())
+		}
+	}()
+
 	for {
 		select {
 		case <-waitTimeout.C:
@@ -122,6 +129,7 @@ func (do *doWithRetry) Action(description string, timeout time.Duration, action
 			log.Printf("%s - performing initial attempt...", description)
 
 			action(context)
+			context.EndIteration()
 			if context.Error != nil {
 				log.Printf("%s - initial attempt failed: %s.",
 					description,
@@ -150,6 +158,7 @@ func (do *doWithRetry) Action(description string, timeout time.Duration, action
 			)
 
 			action(context)
+			context.EndIteration()
 			if context.Error != nil {
 				log.Printf("%s - attempt %d failed: %s.",
 					description,
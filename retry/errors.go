@@ -1,10 +1,17 @@
 package retry
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 )
 
+// RetryReasonTransientNetworkError is the retry reason recorded when an operation is retried due to a transient network error.
+const RetryReasonTransientNetworkError = "TRANSIENT_NETWORK_ERROR"
+
 // IsTimeoutError determines whether the specified error represents an operation timeout.
 func IsTimeoutError(err error) bool {
 	_, ok := err.(*OperationTimeoutError)
@@ -34,3 +41,39 @@ func (timeoutError *OperationTimeoutError) Error() string {
 }
 
 var _ error = &OperationTimeoutError{}
+
+// IsTransientNetworkError determines whether the specified error represents a transient network or TLS failure that occurred before the request reached CloudControl (e.g. a refused connection, a DNS timeout, or a failed TLS handshake).
+//
+// Only these failures are safe to retry for operations that are not idempotent (such as deploying a server); failures that may have occurred after the request was sent (e.g. a connection reset while waiting for the response) are not considered transient, and neither are permanent failures (such as DNS lookups for a non-existent host).
+//
+// Retrying is opt-in: callers that want to retry these errors must check for them and call Context.RetryFor(RetryReasonTransientNetworkError).
+func IsTransientNetworkError(err error) bool {
+	switch networkError := err.(type) {
+	case nil:
+		return false
+	case *url.Error:
+		return IsTransientNetworkError(networkError.Err)
+	case *net.DNSError:
+		return networkError.IsTimeout || networkError.IsTemporary
+	case *net.OpError:
+		// Only failures to establish a connection are known to have happened before the request was sent.
+		if networkError.Op != "dial" {
+			return false
+		}
+
+		switch dialError := networkError.Err.(type) {
+		case *net.DNSError:
+			return IsTransientNetworkError(dialError)
+		default:
+			return true
+		}
+	case tls.RecordHeaderError:
+		// The server did not respond with a TLS handshake.
+		return true
+	case net.Error:
+		// http.Transport reports a TLS handshake timeout using an unexported error type.
+		return networkError.Timeout() && strings.Contains(networkError.Error(), "TLS handshake timeout")
+	}
+
+	return false
+}
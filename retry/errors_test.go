@@ -0,0 +1,151 @@
+package retry
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/DimensionDataResearch/dd-cloud-compute-terraform/assert"
+)
+
+// Unit test - refused connections, DNS timeouts, and failed TLS handshakes (where the request never reached CloudControl) are transient.
+func TestIsTransientNetworkError_Transient(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	assert.IsTrue("ECONNREFUSED", IsTransientNetworkError(&url.Error{
+		Op:  "Post",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: &net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+		},
+	}))
+	assert.IsTrue("DNS timeout", IsTransientNetworkError(&net.DNSError{
+		Err:       "i/o timeout",
+		Name:      "api-au.dimensiondata.com",
+		IsTimeout: true,
+	}))
+	assert.IsTrue("DNS timeout while dialling", IsTransientNetworkError(&url.Error{
+		Op:  "Get",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: &net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: &net.DNSError{
+				Err:       "i/o timeout",
+				Name:      "api-au.dimensiondata.com",
+				IsTimeout: true,
+			},
+		},
+	}))
+	assert.IsTrue("TLS record header", IsTransientNetworkError(&url.Error{
+		Op:  "Get",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"},
+	}))
+	assert.IsTrue("TLS handshake timeout", IsTransientNetworkError(&url.Error{
+		Op:  "Get",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: testTimeoutError("net/http: TLS handshake timeout"),
+	}))
+}
+
+// Unit test - failures that may have happened after the request reached CloudControl, DNS lookups for non-existent hosts, and other errors are not transient.
+func TestIsTransientNetworkError_NotTransient(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	assert.IsFalse("nil", IsTransientNetworkError(nil))
+	assert.IsFalse("NXDOMAIN", IsTransientNetworkError(&url.Error{
+		Op:  "Get",
+		URL: "https://api-xx.dimensiondata.com/",
+		Err: &net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: &net.DNSError{
+				Err:  "no such host",
+				Name: "api-xx.dimensiondata.com",
+			},
+		},
+	}))
+	assert.IsFalse("ECONNRESET", IsTransientNetworkError(&url.Error{
+		Op:  "Post",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: &net.OpError{
+			Op:  "read",
+			Net: "tcp",
+			Err: os.NewSyscallError("read", syscall.ECONNRESET),
+		},
+	}))
+	assert.IsFalse("Response timeout", IsTransientNetworkError(&url.Error{
+		Op:  "Post",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: testTimeoutError("net/http: timeout awaiting response headers"),
+	}))
+	assert.IsFalse("Unexpected EOF", IsTransientNetworkError(&url.Error{
+		Op:  "Post",
+		URL: "https://api-au.dimensiondata.com/",
+		Err: io.ErrUnexpectedEOF,
+	}))
+	assert.IsFalse("Other error", IsTransientNetworkError(errors.New("RESOURCE_NOT_FOUND")))
+}
+
+// Unit test - an action that explicitly fails (even due to a transient network error) is not retried.
+func TestDoActionDoesNotOverrideFail(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	do := NewDo(10 * time.Millisecond)
+
+	attempts := 0
+	err := do.Action("Test operation", 1*time.Second, func(context Context) {
+		attempts++
+		context.Fail(&net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+		})
+	})
+
+	assert.IsTrue("Error != nil", err != nil)
+	assert.EqualsInt("Attempts", 1, attempts)
+}
+
+// Unit test - an action can opt in to retrying transient network errors.
+func TestDoActionRetriesTransientNetworkError(test *testing.T) {
+	assert := assert.ForTest(test)
+
+	do := NewDo(10 * time.Millisecond)
+
+	attempts := 0
+	err := do.Action("Test operation", 1*time.Second, func(context Context) {
+		attempts++
+		if attempts == 1 {
+			dialError := &net.OpError{
+				Op:  "dial",
+				Net: "tcp",
+				Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+			}
+			if IsTransientNetworkError(dialError) {
+				context.RetryFor(RetryReasonTransientNetworkError)
+			} else {
+				context.Fail(dialError)
+			}
+		}
+	})
+
+	assert.IsTrue("Error == nil", err == nil)
+	assert.EqualsInt("Attempts", 2, attempts)
+}
+
+// A net.Error that represents a timeout.
+type testTimeoutError string
+
+func (err testTimeoutError) Error() string   { return string(err) }
+func (err testTimeoutError) Timeout() bool   { return true }
+func (err testTimeoutError) Temporary() bool { return true }